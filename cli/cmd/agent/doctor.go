@@ -1,17 +1,31 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/health"
+	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/health/ai"
+	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/support"
 	"github.com/spf13/cobra"
 )
 
 var (
-	doctorFix    bool
-	doctorJSON   bool
-	doctorFormat string
+	doctorFix                 bool
+	doctorJSON                bool
+	doctorFormat              string
+	doctorSupportDump         string
+	doctorIncludeAudioSamples int
+	doctorExplain             bool
+	doctorBackend             string
+	doctorLanguage            string
+	doctorOutput              string
+	doctorCheck               []string
+	doctorSkip                []string
+	doctorNoColor             bool
 )
 
 var doctorCmd = &cobra.Command{
@@ -31,23 +45,49 @@ Checks include:
 Exit codes:
   0 - All checks passed
   1 - Warnings detected (non-critical)
-  2 - Failures detected (critical)`,
+  2 - Failures detected (critical)
+
+Use --support-dump <path|-> to gather a diagnostic bundle (health results,
+container logs, redacted config, recent CDRs, provider connectivity traces,
+and system info) as a tar.gz for attaching to bug reports.
+
+Use --explain to have an AI backend (--backend openai|anthropic|ollama)
+suggest remediation steps for any failing or warning check. Hostnames, IPs,
+and API-key tails are stripped before anything is sent to the backend.
+
+--format markdown renders a GitHub-flavored report suitable for pasting into
+an issue. Text output is colorized by severity unless --no-color is passed
+or NO_COLOR is set. --check and --skip values shell-complete from the live
+check registry, and doctor fix's --only from the live fix registry.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		checker := health.NewChecker(verbose)
-		
+
 		// Run health checks
-		result, err := checker.RunAll()
+		result, err := checker.RunSelected(doctorCheck, doctorSkip)
 		if err != nil {
 			return fmt.Errorf("health check failed: %w", err)
 		}
-		
+
+		if doctorSupportDump != "" {
+			return writeSupportDump(result)
+		}
+
+		if doctorExplain {
+			if err := explainFailures(checker, result); err != nil {
+				return err
+			}
+		}
+
 		// Output results
-		if doctorJSON {
-			return result.OutputJSON(os.Stdout)
+		format := doctorFormat
+		if doctorJSON || doctorOutput == "json" {
+			format = "json"
 		}
-		
-		result.OutputText(os.Stdout)
-		
+		color := colorEnabled(doctorNoColor)
+		if err := result.Render(os.Stdout, format, color); err != nil {
+			return err
+		}
+
 		// If --fix requested and there are issues
 		if doctorFix && (result.CriticalCount > 0 || result.WarnCount > 0) {
 			fmt.Println("")
@@ -66,11 +106,11 @@ Exit codes:
 				fmt.Println("")
 				
 				// Re-run checks
-				result, err = checker.RunAll()
+				result, err = checker.RunSelected(doctorCheck, doctorSkip)
 				if err != nil {
 					return err
 				}
-				result.OutputText(os.Stdout)
+				result.OutputText(os.Stdout, color)
 			} else {
 				fmt.Println("⚠️  No issues could be auto-fixed")
 				fmt.Println("   Manual intervention required")
@@ -92,6 +132,84 @@ func init() {
 	doctorCmd.Flags().BoolVar(&doctorFix, "fix", false, "attempt to auto-fix issues")
 	doctorCmd.Flags().BoolVar(&doctorJSON, "json", false, "output results as JSON")
 	doctorCmd.Flags().StringVar(&doctorFormat, "format", "text", "output format: text|json|markdown")
-	
+	doctorCmd.Flags().StringVar(&doctorSupportDump, "support-dump", "", "write a diagnostic bundle (tar.gz) to the given path, or - for stdout")
+	doctorCmd.Flags().IntVar(&doctorIncludeAudioSamples, "include-audio-samples", 0, "embed the last N seconds of pipeline recordings in the support dump")
+	doctorCmd.Flags().BoolVar(&doctorExplain, "explain", false, "ask an AI backend to explain failing/warning checks")
+	doctorCmd.Flags().StringVar(&doctorBackend, "backend", "openai", fmt.Sprintf("AI backend to use with --explain (%s)", strings.Join(ai.Names(), "|")))
+	doctorCmd.Flags().StringVar(&doctorLanguage, "language", "en", "language to render --explain output in")
+	doctorCmd.Flags().StringVar(&doctorOutput, "output", "text", "output format for --explain: text|json")
+	doctorCmd.Flags().StringSliceVar(&doctorCheck, "check", nil, "run only these check IDs (default: all)")
+	doctorCmd.Flags().StringSliceVar(&doctorSkip, "skip", nil, "skip these check IDs")
+	doctorCmd.Flags().BoolVar(&doctorNoColor, "no-color", false, "disable ANSI colorization of text output")
+
+	mustRegisterFlagCompletion(doctorCmd, "check", completeCheckIDs)
+	mustRegisterFlagCompletion(doctorCmd, "skip", completeCheckIDs)
+	mustRegisterFlagCompletion(doctorCmd, "format", completeFormats)
+
 	rootCmd.AddCommand(doctorCmd)
+	doctorCmd.AddCommand(doctorWatchCmd)
+}
+
+// mustRegisterFlagCompletion wires a dynamic completion function to a flag
+// that must already exist on cmd. It panics on error instead of discarding
+// it, because a failure here means a flag name was typo'd or registered
+// before its command's own init() ran — a startup bug, not a runtime one.
+func mustRegisterFlagCompletion(cmd *cobra.Command, flag string, fn func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective)) {
+	if err := cmd.RegisterFlagCompletionFunc(flag, fn); err != nil {
+		panic(fmt.Sprintf("register completion for --%s on %s: %v", flag, cmd.Name(), err))
+	}
+}
+
+// colorEnabled reports whether text output should be colorized: disabled by
+// --no-color or by the NO_COLOR convention (https://no-color.org).
+func colorEnabled(noColor bool) bool {
+	if noColor {
+		return false
+	}
+	_, set := os.LookupEnv("NO_COLOR")
+	return !set
+}
+
+// explainFailures configures the selected AI backend and asks it to explain
+// every failing or warning check, anonymizing hostnames/IPs/API-key tails
+// before anything leaves the host.
+func explainFailures(checker *health.Checker, result *health.Result) error {
+	backend, err := ai.Get(doctorBackend)
+	if err != nil {
+		return err
+	}
+
+	token := os.Getenv(strings.ToUpper(doctorBackend) + "_API_KEY")
+	if err := backend.Configure(token, doctorLanguage); err != nil {
+		return fmt.Errorf("configure %s backend: %w", doctorBackend, err)
+	}
+
+	return checker.Explain(context.Background(), result, backend)
+}
+
+// writeSupportDump gathers health results, container logs, redacted config,
+// and (optionally) recent audio samples into a single tar.gz artifact for
+// attaching to bug reports, mirroring `cscli support-dump`.
+func writeSupportDump(result *health.Result) error {
+	opts := support.DefaultOptions()
+	if doctorIncludeAudioSamples > 0 {
+		opts.IncludeAudioSamples = time.Duration(doctorIncludeAudioSamples) * time.Second
+	}
+
+	if doctorSupportDump == "-" {
+		return support.Write(os.Stdout, result, opts)
+	}
+
+	f, err := os.Create(doctorSupportDump)
+	if err != nil {
+		return fmt.Errorf("create support dump: %w", err)
+	}
+	defer f.Close()
+
+	if err := support.Write(f, result, opts); err != nil {
+		return fmt.Errorf("write support dump: %w", err)
+	}
+
+	fmt.Printf("✓ Support dump written to %s\n", doctorSupportDump)
+	return nil
 }
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/health"
+	"github.com/spf13/cobra"
+)
+
+// doctorFormats lists the values --format accepts, shared with shell
+// completion.
+var doctorFormats = []string{"text", "json", "markdown"}
+
+// completeCheckIDs, completeFixActionIDs, and completeFormats back dynamic
+// shell completion for doctor's registry-backed flags, so --check/--skip
+// suggest the live set of health check IDs and --only suggests the live
+// set of fix action IDs, rather than a value baked in at build time.
+//
+// Each command registers these against its own flags from its own init(),
+// after that command's flags are defined — Go runs per-file init()s in
+// lexical filename order, so registering from a shared init() here would
+// run before doctor_fix.go/doctor_watch.go have added their flags.
+func completeCheckIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return health.CheckIDs(), cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeFixActionIDs(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return health.FixActionIDs(), cobra.ShellCompDirectiveNoFileComp
+}
+
+func completeFormats(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return doctorFormats, cobra.ShellCompDirectiveNoFileComp
+}
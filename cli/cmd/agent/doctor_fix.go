@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/health"
+	"github.com/spf13/cobra"
+)
+
+var (
+	fixDryRun bool
+	fixYes    bool
+	fixOnly   string
+	fixList   bool
+)
+
+var doctorFixCmd = &cobra.Command{
+	Use:   "fix",
+	Short: "Apply registered auto-fix actions for failing checks",
+	Long: `Run the doctor health checks and apply the FixActions they advertise.
+
+Each failing or warning check advertises zero or more named fixes (e.g.
+restart-asterisk-container, regenerate-ari-user, pull-missing-image,
+chown-audio-socket). Use --list to see every registered fix, --only to
+rerun a single one without running the full battery of checks again,
+--dry-run to print what would run, and --yes to skip the confirmation
+prompt.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if fixList {
+			for _, a := range health.FixActions() {
+				fmt.Printf("%-28s %s\n", a.ID, a.Description)
+			}
+			return nil
+		}
+
+		checker := health.NewChecker(verbose)
+
+		var ids []string
+		if fixOnly != "" {
+			// --only reruns a single remediation directly; no need to pay
+			// for the full check battery just to throw the result away.
+			ids = []string{fixOnly}
+		} else {
+			result, err := checker.RunSelected(doctorCheck, doctorSkip)
+			if err != nil {
+				return fmt.Errorf("health check failed: %w", err)
+			}
+			ids = collectFixIDs(result)
+		}
+
+		if len(ids) == 0 {
+			fmt.Println("No applicable fixes found.")
+			return nil
+		}
+
+		reader := bufio.NewReader(os.Stdin)
+		applied := 0
+		for _, id := range ids {
+			action, ok := health.GetFixAction(id)
+			if !ok {
+				fmt.Printf("→ %s: no such fix registered, skipping\n", id)
+				continue
+			}
+
+			fmt.Printf("→ %s: %s\n", action.ID, action.Description)
+			if fixDryRun {
+				fmt.Printf("  would run: %s\n", action.Describe())
+				continue
+			}
+			if !fixYes && !confirm(reader, fmt.Sprintf("Apply %s?", action.ID)) {
+				fmt.Println("  skipped")
+				continue
+			}
+			if err := checker.RunFix(id); err != nil {
+				fmt.Printf("  ❌ failed: %v\n", err)
+				continue
+			}
+			fmt.Println("  ✓ applied")
+			applied++
+		}
+
+		if !fixDryRun {
+			fmt.Printf("\n%d fix(es) applied\n", applied)
+		}
+		return nil
+	},
+}
+
+// collectFixIDs gathers the FixHints advertised by every non-OK check,
+// deduplicated and in check order.
+func collectFixIDs(result *health.Result) []string {
+	seen := map[string]bool{}
+	var ids []string
+	for _, cr := range result.Checks {
+		if cr.Severity == health.OK {
+			continue
+		}
+		for _, id := range cr.FixHints {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// confirm reads a full line rather than a single token so trailing
+// whitespace or a pasted multi-char answer doesn't leak into the next
+// prompt — the classic fmt.Scanln footgun terraform's CLI helper works
+// around the same way.
+func confirm(reader *bufio.Reader, prompt string) bool {
+	fmt.Printf("  %s [y/N]: ", prompt)
+	line, _ := reader.ReadString('\n')
+	line = strings.TrimSpace(strings.ToLower(line))
+	return line == "y" || line == "yes"
+}
+
+func init() {
+	doctorFixCmd.Flags().BoolVar(&fixDryRun, "dry-run", false, "print the commands each fix would run, without running them")
+	doctorFixCmd.Flags().BoolVar(&fixYes, "yes", false, "skip interactive confirmation")
+	doctorFixCmd.Flags().StringVar(&fixOnly, "only", "", "run only this fix ID")
+	doctorFixCmd.Flags().BoolVar(&fixList, "list", false, "list registered fix actions and exit")
+	doctorFixCmd.Flags().StringSliceVar(&doctorCheck, "check", nil, "limit to these check IDs when determining applicable fixes")
+	doctorFixCmd.Flags().StringSliceVar(&doctorSkip, "skip", nil, "exclude these check IDs when determining applicable fixes")
+
+	mustRegisterFlagCompletion(doctorFixCmd, "only", completeFixActionIDs)
+	mustRegisterFlagCompletion(doctorFixCmd, "check", completeCheckIDs)
+	mustRegisterFlagCompletion(doctorFixCmd, "skip", completeCheckIDs)
+
+	doctorCmd.AddCommand(doctorFixCmd)
+}
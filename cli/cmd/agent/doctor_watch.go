@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/health"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+)
+
+var (
+	watchInterval time.Duration
+	watchListen   string
+)
+
+var (
+	healthCheckGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ava_health_check",
+		Help: "1 if the named check is currently at the given severity, 0 otherwise.",
+	}, []string{"name", "severity"})
+
+	healthCheckDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ava_health_check_duration_seconds",
+		Help:    "Time RunSelected spent evaluating all checks, per run.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"name"})
+
+	healthLastRunTimestamp = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "ava_health_last_run_timestamp",
+		Help: "Unix timestamp of the last completed health check run.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(healthCheckGauge, healthCheckDuration, healthLastRunTimestamp)
+}
+
+var doctorWatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Continuously run health checks and expose them as Prometheus metrics",
+	Long: `Run the doctor health checks on a fixed interval and serve the results as
+Prometheus metrics, suitable for Kubernetes liveness/readiness probes and
+Grafana dashboards.
+
+Exposes:
+  GET /metrics  - ava_health_check, ava_health_check_duration_seconds,
+                  ava_health_last_run_timestamp
+  GET /healthz  - 200 if no critical checks are failing, 503 otherwise`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		checker := health.NewChecker(verbose)
+
+		runOnce := func() *health.Result {
+			start := time.Now()
+			result, err := checker.RunSelected(doctorCheck, doctorSkip)
+			if err != nil {
+				fmt.Printf("health check run failed: %v\n", err)
+				return nil
+			}
+			healthCheckDuration.WithLabelValues("all").Observe(time.Since(start).Seconds())
+			healthLastRunTimestamp.Set(float64(time.Now().Unix()))
+			recordMetrics(result)
+			return result
+		}
+
+		var latest atomic.Pointer[health.Result]
+		latest.Store(runOnce())
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+			result := latest.Load()
+			// A nil result means the last run itself failed to execute, not
+			// that every check passed — that must not read as healthy.
+			if result == nil || result.CriticalCount > 0 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			} else {
+				w.WriteHeader(http.StatusOK)
+			}
+		})
+
+		go func() {
+			ticker := time.NewTicker(watchInterval)
+			defer ticker.Stop()
+			for range ticker.C {
+				latest.Store(runOnce())
+			}
+		}()
+
+		fmt.Printf("doctor watch listening on %s (interval %s)\n", watchListen, watchInterval)
+		return http.ListenAndServe(watchListen, mux)
+	},
+}
+
+// recordMetrics sets ava_health_check to 1 for the severity a check
+// currently has and 0 for the other two, so a Grafana panel can just sum by
+// severity without needing to track state transitions.
+func recordMetrics(result *health.Result) {
+	severities := []health.Severity{health.OK, health.Warn, health.Critical}
+	for _, cr := range result.Checks {
+		for _, s := range severities {
+			value := 0.0
+			if s == cr.Severity {
+				value = 1
+			}
+			healthCheckGauge.WithLabelValues(cr.Name, s.String()).Set(value)
+		}
+	}
+}
+
+func init() {
+	doctorWatchCmd.Flags().DurationVar(&watchInterval, "interval", 30*time.Second, "how often to re-run health checks")
+	doctorWatchCmd.Flags().StringVar(&watchListen, "listen", ":9102", "address to serve /metrics and /healthz on")
+	doctorWatchCmd.Flags().StringSliceVar(&doctorCheck, "check", nil, "run only these check IDs (default: all)")
+	doctorWatchCmd.Flags().StringSliceVar(&doctorSkip, "skip", nil, "skip these check IDs")
+
+	mustRegisterFlagCompletion(doctorWatchCmd, "check", completeCheckIDs)
+	mustRegisterFlagCompletion(doctorWatchCmd, "skip", completeCheckIDs)
+}
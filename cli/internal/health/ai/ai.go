@@ -0,0 +1,98 @@
+// Package ai provides pluggable AI backends that turn a failing health
+// check into a human-readable remediation suggestion, modeled on k8sgpt's
+// analyze/explain flow.
+package ai
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+)
+
+// Finding is the evidence handed to an Explainer for a single check that
+// did not pass.
+type Finding struct {
+	Name     string
+	Severity string
+	Message  string
+	Evidence map[string]string
+}
+
+// Explainer turns a Finding into a natural-language remediation suggestion.
+// Implementations must not assume Configure is called more than once.
+type Explainer interface {
+	// Configure authenticates the backend and sets the language explanations
+	// should be rendered in (e.g. "en", "es"). token may be empty for
+	// backends that don't require one (e.g. a local Ollama instance).
+	Configure(token, language string) error
+	Explain(ctx context.Context, finding Finding) (string, error)
+}
+
+type factory func() Explainer
+
+var registry = map[string]factory{}
+
+// Register adds a named backend to the registry. Backends call this from
+// an init() function so new providers can be added without touching
+// doctorCmd.
+func Register(name string, f factory) {
+	registry[name] = f
+}
+
+// Get constructs the named backend, or an error if it isn't registered.
+func Get(name string) (Explainer, error) {
+	f, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown AI backend %q (available: %v)", name, Names())
+	}
+	return f(), nil
+}
+
+// Names lists the registered backend names.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for n := range registry {
+		names = append(names, n)
+	}
+	return names
+}
+
+var (
+	ipPattern = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+	// hostnamePattern matches dotted FQDNs (e.g. ari-prod-01.internal.example.com).
+	// The final label must be letters-only so IPs, already handled above,
+	// never match here.
+	hostnamePattern = regexp.MustCompile(`\b(?:[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?\.)+[A-Za-z]{2,}\b`)
+	keyPattern      = regexp.MustCompile(`\b[A-Za-z0-9_-]{20,}\b`)
+)
+
+// Anonymize strips hostnames, IP addresses, and API-key-shaped tokens out of
+// a Finding's message and evidence before it's sent to a remote backend. It
+// is called once, centrally, by health.Explain before any Explainer is
+// invoked, so no backend can forget to scrub evidence itself.
+func Anonymize(f Finding) Finding {
+	f.Message = anonymizeString(f.Message)
+	if f.Evidence != nil {
+		scrubbed := make(map[string]string, len(f.Evidence))
+		for k, v := range f.Evidence {
+			scrubbed[k] = anonymizeString(v)
+		}
+		f.Evidence = scrubbed
+	}
+	return f
+}
+
+func anonymizeString(s string) string {
+	s = ipPattern.ReplaceAllString(s, "<redacted-ip>")
+	s = hostnamePattern.ReplaceAllString(s, "<redacted-host>")
+	s = keyPattern.ReplaceAllStringFunc(s, func(tok string) string {
+		// Anything this long and opaque (and not already redacted above) is
+		// treated as a credential tail.
+		if net.ParseIP(tok) != nil {
+			return tok
+		}
+		return tok[:4] + "…<redacted>"
+	})
+	return s
+}
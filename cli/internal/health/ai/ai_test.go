@@ -0,0 +1,60 @@
+package ai
+
+import "testing"
+
+func TestAnonymizeString(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "ip address",
+			in:   "failed to reach 10.0.0.5",
+			want: "failed to reach <redacted-ip>",
+		},
+		{
+			name: "dotted hostname",
+			in:   "host ari-prod-01.internal.example.com unreachable",
+			want: "host <redacted-host> unreachable",
+		},
+		{
+			name: "key tail",
+			in:   "key sk-ABCDEFGHIJKLMNOPQRSTUVWX1234 rejected",
+			want: "key sk-A…<redacted> rejected",
+		},
+		{
+			name: "ip and hostname and key together",
+			in:   "host ari-prod-01.internal.example.com at 10.0.0.5 failed, key sk-ABCDEFGHIJKLMNOPQRSTUVWX1234",
+			want: "host <redacted-host> at <redacted-ip> failed, key sk-A…<redacted>",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := anonymizeString(tc.in); got != tc.want {
+				t.Errorf("anonymizeString(%q) = %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAnonymizeFinding(t *testing.T) {
+	f := Finding{
+		Name:     "provider-connectivity",
+		Severity: "critical",
+		Message:  "timed out calling api.example.com",
+		Evidence: map[string]string{"host": "10.0.0.5"},
+	}
+
+	got := Anonymize(f)
+	if got.Message != "timed out calling <redacted-host>" {
+		t.Errorf("Message = %q", got.Message)
+	}
+	if got.Evidence["host"] != "<redacted-ip>" {
+		t.Errorf("Evidence[host] = %q", got.Evidence["host"])
+	}
+	if got.Name != f.Name || got.Severity != f.Severity {
+		t.Errorf("Anonymize should leave Name/Severity untouched: got %+v", got)
+	}
+}
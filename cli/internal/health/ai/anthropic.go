@@ -0,0 +1,76 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func init() {
+	Register("anthropic", func() Explainer { return &anthropicExplainer{baseURL: "https://api.anthropic.com/v1"} })
+}
+
+// anthropicExplainer talks to the Anthropic Messages API.
+type anthropicExplainer struct {
+	baseURL  string
+	token    string
+	language string
+}
+
+func (e *anthropicExplainer) Configure(token, language string) error {
+	if token == "" {
+		return fmt.Errorf("anthropic backend requires an API token")
+	}
+	e.token = token
+	if language != "" {
+		e.language = language
+	}
+	return nil
+}
+
+func (e *anthropicExplainer) Explain(ctx context.Context, finding Finding) (string, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"model":      "claude-3-5-haiku-latest",
+		"max_tokens": 512,
+		"system":     remediationPrompt(e.language),
+		"messages": []map[string]string{
+			{"role": "user", "content": findingPrompt(finding)},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.baseURL+"/messages", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("x-api-key", e.token)
+	req.Header.Set("anthropic-version", "2023-06-01")
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("anthropic backend returned HTTP %d", resp.StatusCode)
+	}
+
+	var out struct {
+		Content []struct {
+			Text string `json:"text"`
+		} `json:"content"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", err
+	}
+	if len(out.Content) == 0 {
+		return "", fmt.Errorf("anthropic backend returned no content")
+	}
+	return out.Content[0].Text, nil
+}
@@ -0,0 +1,64 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func init() {
+	Register("ollama", func() Explainer { return &ollamaExplainer{baseURL: "http://localhost:11434"} })
+}
+
+// ollamaExplainer talks to a local Ollama instance. Unlike the other
+// backends it has no token requirement.
+type ollamaExplainer struct {
+	baseURL  string
+	model    string
+	language string
+}
+
+func (e *ollamaExplainer) Configure(token, language string) error {
+	e.model = "llama3.1"
+	if language != "" {
+		e.language = language
+	}
+	return nil
+}
+
+func (e *ollamaExplainer) Explain(ctx context.Context, finding Finding) (string, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"model":  e.model,
+		"prompt": remediationPrompt(e.language) + "\n\n" + findingPrompt(finding),
+		"stream": false,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.baseURL+"/api/generate", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("ollama unreachable at %s: %w", e.baseURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("ollama backend returned HTTP %d", resp.StatusCode)
+	}
+
+	var out struct {
+		Response string `json:"response"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", err
+	}
+	return out.Response, nil
+}
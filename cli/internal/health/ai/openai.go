@@ -0,0 +1,76 @@
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func init() {
+	Register("openai", func() Explainer { return &openAIExplainer{baseURL: "https://api.openai.com/v1"} })
+}
+
+// openAIExplainer talks to any OpenAI-compatible chat completions endpoint.
+type openAIExplainer struct {
+	baseURL  string
+	token    string
+	language string
+}
+
+func (e *openAIExplainer) Configure(token, language string) error {
+	if token == "" {
+		return fmt.Errorf("openai backend requires an API token")
+	}
+	e.token = token
+	if language != "" {
+		e.language = language
+	}
+	return nil
+}
+
+func (e *openAIExplainer) Explain(ctx context.Context, finding Finding) (string, error) {
+	body, err := json.Marshal(map[string]interface{}{
+		"model": "gpt-4o-mini",
+		"messages": []map[string]string{
+			{"role": "system", "content": remediationPrompt(e.language)},
+			{"role": "user", "content": findingPrompt(finding)},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+e.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("openai backend returned HTTP %d", resp.StatusCode)
+	}
+
+	var out struct {
+		Choices []struct {
+			Message struct {
+				Content string `json:"content"`
+			} `json:"message"`
+		} `json:"choices"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return "", err
+	}
+	if len(out.Choices) == 0 {
+		return "", fmt.Errorf("openai backend returned no choices")
+	}
+	return out.Choices[0].Message.Content, nil
+}
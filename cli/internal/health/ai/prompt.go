@@ -0,0 +1,30 @@
+package ai
+
+import "fmt"
+
+// remediationPrompt is the shared system/instruction prompt every backend
+// sends ahead of the finding. Keeping it in one place means the backends
+// stay consistent when the wording changes.
+func remediationPrompt(language string) string {
+	if language == "" {
+		language = "en"
+	}
+	return fmt.Sprintf(
+		"You are an assistant embedded in the `ava doctor` CLI for an Asterisk "+
+			"AI Voice Agent deployment. Given a failing or warning health check "+
+			"and its evidence, explain the likely root cause and give concrete, "+
+			"numbered remediation steps. Respond in language code %q. Be concise.",
+		language,
+	)
+}
+
+func findingPrompt(f Finding) string {
+	prompt := fmt.Sprintf("Check: %s\nSeverity: %s\nMessage: %s\n", f.Name, f.Severity, f.Message)
+	if len(f.Evidence) > 0 {
+		prompt += "Evidence:\n"
+		for k, v := range f.Evidence {
+			prompt += fmt.Sprintf("  %s: %s\n", k, v)
+		}
+	}
+	return prompt
+}
@@ -0,0 +1,35 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/health/ai"
+)
+
+// Explain sends each failing or warning check in r to backend and fills in
+// its Remediation field. Checks that already passed are left untouched. A
+// backend error on one check doesn't stop the others from being explained.
+func (c *Checker) Explain(ctx context.Context, r *Result, backend ai.Explainer) error {
+	for i := range r.Checks {
+		cr := &r.Checks[i]
+		if cr.Severity == OK {
+			continue
+		}
+
+		finding := ai.Anonymize(ai.Finding{
+			Name:     cr.Name,
+			Severity: cr.Severity.String(),
+			Message:  cr.Message,
+			Evidence: cr.Evidence,
+		})
+
+		explanation, err := backend.Explain(ctx, finding)
+		if err != nil {
+			cr.Remediation = fmt.Sprintf("(explanation unavailable: %v)", err)
+			continue
+		}
+		cr.Remediation = explanation
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package health
+
+import (
+	"fmt"
+	"os/exec"
+	"sort"
+)
+
+// FixAction is a named remediation a check can advertise via
+// CheckResult.FixHints. Describe returns the command(s) the action would
+// run, for --dry-run; Run actually performs it.
+type FixAction struct {
+	ID          string
+	Description string
+	Describe    func() string
+	Run         func() error
+}
+
+var fixRegistry = map[string]FixAction{}
+
+func registerFix(action FixAction) {
+	fixRegistry[action.ID] = action
+}
+
+func init() {
+	registerFix(FixAction{
+		ID:          "restart-asterisk-container",
+		Description: "Restart the asterisk container",
+		Describe:    func() string { return "docker restart asterisk" },
+		Run:         func() error { return exec.Command("docker", "restart", "asterisk").Run() },
+	})
+	registerFix(FixAction{
+		ID:          "regenerate-ari-user",
+		Description: "Reload the Asterisk ARI configuration",
+		Describe:    func() string { return `docker exec asterisk asterisk -rx "ari reload"` },
+		Run:         func() error { return exec.Command("docker", "exec", "asterisk", "asterisk", "-rx", "ari reload").Run() },
+	})
+	registerFix(FixAction{
+		ID:          "pull-missing-image",
+		Description: "Pull the AVA agent image",
+		Describe:    func() string { return "docker pull ghcr.io/hkjarral/asterisk-ai-voice-agent:latest" },
+		Run: func() error {
+			return exec.Command("docker", "pull", "ghcr.io/hkjarral/asterisk-ai-voice-agent:latest").Run()
+		},
+	})
+	registerFix(FixAction{
+		ID:          "chown-audio-socket",
+		Description: "Fix ownership/permissions of the AudioSocket unix socket",
+		Describe: func() string {
+			return "chown ava:ava /var/run/ava/audiosocket.sock && chmod 660 /var/run/ava/audiosocket.sock"
+		},
+		Run: func() error {
+			return exec.Command("sh", "-c", "chown ava:ava /var/run/ava/audiosocket.sock && chmod 660 /var/run/ava/audiosocket.sock").Run()
+		},
+	})
+}
+
+// GetFixAction looks up a registered fix by ID.
+func GetFixAction(id string) (FixAction, bool) {
+	action, ok := fixRegistry[id]
+	return action, ok
+}
+
+// FixActions returns every registered fix, sorted by ID for stable listing.
+func FixActions() []FixAction {
+	actions := make([]FixAction, 0, len(fixRegistry))
+	for _, a := range fixRegistry {
+		actions = append(actions, a)
+	}
+	sort.Slice(actions, func(i, j int) bool { return actions[i].ID < actions[j].ID })
+	return actions
+}
+
+// FixActionIDs returns the IDs of every registered fix, sorted.
+func FixActionIDs() []string {
+	actions := FixActions()
+	ids := make([]string, len(actions))
+	for i, a := range actions {
+		ids[i] = a.ID
+	}
+	return ids
+}
+
+// RunFix executes the named fix action.
+func (c *Checker) RunFix(id string) error {
+	action, ok := GetFixAction(id)
+	if !ok {
+		return fmt.Errorf("no fix registered with id %q", id)
+	}
+	return action.Run()
+}
@@ -0,0 +1,122 @@
+// Package health implements the diagnostic checks behind `ava doctor`.
+package health
+
+// Severity classifies how urgently a check result needs attention.
+type Severity int
+
+const (
+	OK Severity = iota
+	Warn
+	Critical
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Warn:
+		return "warn"
+	case Critical:
+		return "critical"
+	default:
+		return "ok"
+	}
+}
+
+// CheckResult is the outcome of a single health check.
+type CheckResult struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name"`
+	Severity Severity          `json:"severity"`
+	Message  string            `json:"message"`
+	Evidence map[string]string `json:"evidence,omitempty"`
+	// FixHints lists the IDs of registered FixActions this check advertises.
+	FixHints []string `json:"fix_hints,omitempty"`
+	// Remediation holds the AI-generated explanation set by Explain, if any.
+	Remediation string `json:"remediation,omitempty"`
+}
+
+// Result aggregates every CheckResult from a single RunAll/RunSelected pass.
+type Result struct {
+	Checks        []CheckResult `json:"checks"`
+	CriticalCount int           `json:"critical_count"`
+	WarnCount     int           `json:"warn_count"`
+	OKCount       int           `json:"ok_count"`
+}
+
+// Checker runs the registered battery of health checks.
+type Checker struct {
+	verbose bool
+}
+
+// NewChecker returns a Checker. When verbose is true, checks may include
+// additional diagnostic detail in their Evidence maps.
+func NewChecker(verbose bool) *Checker {
+	return &Checker{verbose: verbose}
+}
+
+// RunAll runs every registered check and aggregates the results.
+func (c *Checker) RunAll() (*Result, error) {
+	return c.RunSelected(nil, nil)
+}
+
+// RunSelected runs the registered checks, restricted to `only` (if
+// non-empty) and with `skip` excluded. Unknown IDs in either list are
+// silently ignored; CheckIDs lists the valid ones.
+func (c *Checker) RunSelected(only, skip []string) (*Result, error) {
+	onlySet := toSet(only)
+	skipSet := toSet(skip)
+
+	result := &Result{}
+	for _, chk := range registry {
+		if len(onlySet) > 0 && !onlySet[chk.ID] {
+			continue
+		}
+		if skipSet[chk.ID] {
+			continue
+		}
+
+		cr := chk.run(c)
+		cr.ID = chk.ID
+		cr.Name = chk.Name
+		result.Checks = append(result.Checks, cr)
+		switch cr.Severity {
+		case Critical:
+			result.CriticalCount++
+		case Warn:
+			result.WarnCount++
+		default:
+			result.OKCount++
+		}
+	}
+	return result, nil
+}
+
+func toSet(ids []string) map[string]bool {
+	if len(ids) == 0 {
+		return nil
+	}
+	set := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set
+}
+
+// AutoFix attempts every FixAction advertised by a failing or warning check,
+// returning the number it successfully ran. For interactive confirmation,
+// dry-run, and single-fix reruns, use the `doctor fix` subcommand instead,
+// which drives the same FixAction registry.
+func (c *Checker) AutoFix(r *Result) (int, error) {
+	fixed := 0
+	for _, cr := range r.Checks {
+		if cr.Severity == OK {
+			continue
+		}
+		for _, id := range cr.FixHints {
+			if err := c.RunFix(id); err != nil {
+				continue
+			}
+			fixed++
+		}
+	}
+	return fixed, nil
+}
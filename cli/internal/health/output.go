@@ -0,0 +1,125 @@
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[31m"
+	ansiYellow = "\x1b[33m"
+	ansiGreen  = "\x1b[32m"
+)
+
+func severityColor(s Severity) string {
+	switch s {
+	case Warn:
+		return ansiYellow
+	case Critical:
+		return ansiRed
+	default:
+		return ansiGreen
+	}
+}
+
+func colorize(s, code string, enabled bool) string {
+	if !enabled {
+		return s
+	}
+	return code + s + ansiReset
+}
+
+// Render writes the result in the given format ("text", "json", or
+// "markdown"), defaulting to "text" for anything else. color only affects
+// the text format.
+func (r *Result) Render(w io.Writer, format string, color bool) error {
+	switch format {
+	case "json":
+		return r.OutputJSON(w)
+	case "markdown":
+		return r.OutputMarkdown(w)
+	default:
+		r.OutputText(w, color)
+		return nil
+	}
+}
+
+// OutputJSON writes the result as JSON.
+func (r *Result) OutputJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// OutputText writes the result as a human-readable report. When color is
+// true, each check's line is wrapped in an ANSI color matching its
+// severity; callers should pass false when NO_COLOR is set or --no-color
+// was given.
+func (r *Result) OutputText(w io.Writer, color bool) {
+	fmt.Fprintln(w, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+	fmt.Fprintln(w, colorize("🩺 AVA Doctor", ansiBold, color))
+	fmt.Fprintln(w, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━")
+	fmt.Fprintln(w, "")
+	for _, cr := range r.Checks {
+		badge := "✅"
+		switch cr.Severity {
+		case Warn:
+			badge = "⚠️ "
+		case Critical:
+			badge = "❌"
+		}
+		line := fmt.Sprintf("%s %-24s %s", badge, cr.Name, cr.Message)
+		fmt.Fprintln(w, colorize(line, severityColor(cr.Severity), color))
+		if cr.Remediation != "" {
+			fmt.Fprintf(w, "   💡 %s\n", cr.Remediation)
+		}
+	}
+	fmt.Fprintln(w, "")
+	fmt.Fprintf(w, "%d ok, %d warning(s), %d critical\n", r.OKCount, r.WarnCount, r.CriticalCount)
+}
+
+// OutputMarkdown renders the result as a GitHub-flavored markdown report,
+// suitable for pasting into an issue: a heading and status badge per check,
+// with verbose evidence tucked into a collapsed <details> block.
+func (r *Result) OutputMarkdown(w io.Writer) error {
+	fmt.Fprintln(w, "# AVA Doctor Report")
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "%d ok, %d warning(s), %d critical\n\n", r.OKCount, r.WarnCount, r.CriticalCount)
+
+	for _, cr := range r.Checks {
+		badge := "✅"
+		switch cr.Severity {
+		case Warn:
+			badge = "⚠️"
+		case Critical:
+			badge = "❌"
+		}
+
+		fmt.Fprintf(w, "## %s %s\n\n", badge, cr.Name)
+		fmt.Fprintf(w, "%s\n\n", cr.Message)
+		if cr.Remediation != "" {
+			fmt.Fprintf(w, "> 💡 %s\n\n", cr.Remediation)
+		}
+		if len(cr.Evidence) > 0 {
+			fmt.Fprintln(w, "<details><summary>Evidence</summary>")
+			fmt.Fprintln(w)
+			fmt.Fprintln(w, "```")
+			keys := make([]string, 0, len(cr.Evidence))
+			for k := range cr.Evidence {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				fmt.Fprintf(w, "%s: %s\n", k, cr.Evidence[k])
+			}
+			fmt.Fprintln(w, "```")
+			fmt.Fprintln(w, "</details>")
+			fmt.Fprintln(w)
+		}
+	}
+	return nil
+}
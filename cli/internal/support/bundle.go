@@ -0,0 +1,377 @@
+// Package support assembles the diagnostic bundle produced by
+// `ava doctor --support-dump`.
+package support
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"time"
+
+	"github.com/hkjarral/asterisk-ai-voice-agent/cli/internal/health"
+)
+
+// Options controls what goes into a support bundle.
+type Options struct {
+	// Containers are the docker containers whose logs should be collected.
+	Containers []string
+	// ConfigPaths are config files to include, redacted.
+	ConfigPaths []string
+	// IncludeAudioSamples, when > 0, trims each recent pipeline recording
+	// found under AudioSamplesDir to its trailing N seconds and embeds it.
+	IncludeAudioSamples time.Duration
+	AudioSamplesDir     string
+	// CDRPath is the Asterisk CDR CSV file to sample recent call records from.
+	CDRPath string
+	// CDRLines caps how many trailing CDR lines are embedded.
+	CDRLines int
+	// ProviderEndpoints are the provider APIs probed for the connectivity
+	// trace (name plus a cheap, side-effect-free URL to hit).
+	ProviderEndpoints []ProviderEndpoint
+}
+
+// ProviderEndpoint is one provider API probed for the connectivity trace.
+type ProviderEndpoint struct {
+	Name string
+	URL  string
+}
+
+// DefaultOptions returns the bundle contents collected when no flags
+// override them.
+func DefaultOptions() Options {
+	return Options{
+		Containers:      []string{"asterisk", "ava-agent", "ava-audiosocket"},
+		ConfigPaths:     []string{"/etc/ava/config.yml"},
+		AudioSamplesDir: "/var/lib/ava/recordings",
+		CDRPath:         "/var/log/asterisk/cdr-csv/Master.csv",
+		CDRLines:        200,
+		ProviderEndpoints: []ProviderEndpoint{
+			{Name: "openai", URL: "https://api.openai.com/v1/models"},
+			{Name: "anthropic", URL: "https://api.anthropic.com/v1/models"},
+			{Name: "deepgram", URL: "https://api.deepgram.com/v1/projects"},
+		},
+	}
+}
+
+// secretFieldPattern matches common "key: value" secret assignments in YAML
+// or .env style config so their values can be redacted before the file is
+// packed into the bundle. The value alternates over a quoted string (which
+// may contain spaces) or a bare run of non-comment characters, so a quoted
+// "hunter 2 secret" or a trailing "# prod key" comment don't make the value
+// swallow (or get swallowed by) the rest of the line.
+var secretFieldPattern = regexp.MustCompile(`(?im)^(\s*[\w.]*(?:api[_-]?key|token|secret|password)[\w.]*\s*[:=]\s*)("[^"]*"|'[^']*'|[^\s#][^#]*?)([ \t]*#.*)?$`)
+
+func redact(data []byte) []byte {
+	return secretFieldPattern.ReplaceAll(data, []byte("$1***REDACTED***$3"))
+}
+
+// unquoteValue strips the surrounding quotes secretFieldPattern may have
+// captured, so a fingerprint is stable regardless of how the value was
+// quoted in config.
+func unquoteValue(v string) string {
+	if len(v) >= 2 {
+		if (v[0] == '"' && v[len(v)-1] == '"') || (v[0] == '\'' && v[len(v)-1] == '\'') {
+			return v[1 : len(v)-1]
+		}
+	}
+	return v
+}
+
+// fingerprint reduces a secret to a short, non-reversible hash so two dumps
+// can be compared (e.g. "did the key change between these two reports?")
+// without ever putting the secret itself on disk.
+func fingerprint(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// Write builds a support bundle and writes it as a gzip'd tar stream to w.
+// The tar and gzip footers are only flushed on Close, so a failure there
+// means the archive is truncated; both Close errors are checked rather than
+// deferred so callers don't report success over a corrupt bundle.
+func Write(w io.Writer, result *health.Result, opts Options) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	writeErr := writeEntries(tw, result, opts)
+
+	closeTarErr := tw.Close()
+	closeGzErr := gz.Close()
+
+	if writeErr != nil {
+		return writeErr
+	}
+	if closeTarErr != nil {
+		return fmt.Errorf("close tar writer: %w", closeTarErr)
+	}
+	if closeGzErr != nil {
+		return fmt.Errorf("close gzip writer: %w", closeGzErr)
+	}
+	return nil
+}
+
+func writeEntries(tw *tar.Writer, result *health.Result, opts Options) error {
+	if err := addJSON(tw, "health.json", result); err != nil {
+		return err
+	}
+	if err := addSystemInfo(tw); err != nil {
+		return err
+	}
+	for _, name := range opts.Containers {
+		if err := addContainerLog(tw, name); err != nil {
+			// Missing containers shouldn't abort the whole dump.
+			addError(tw, fmt.Sprintf("docker/%s.log.err", name), err)
+		}
+	}
+	for _, path := range opts.ConfigPaths {
+		if err := addRedactedFile(tw, path); err != nil {
+			addError(tw, "config/"+baseName(path)+".err", err)
+		}
+		if err := addAPIKeyFingerprints(tw, path); err != nil {
+			addError(tw, "security/"+baseName(path)+".fingerprints.err", err)
+		}
+	}
+	if err := addRecentCDRs(tw, opts.CDRPath, opts.CDRLines); err != nil {
+		addError(tw, "cdr/recent.csv.err", err)
+	}
+	if err := addProviderConnectivity(tw, opts.ProviderEndpoints); err != nil {
+		addError(tw, "providers/connectivity.json.err", err)
+	}
+	if opts.IncludeAudioSamples > 0 {
+		if err := addAudioSamples(tw, opts.AudioSamplesDir, opts.IncludeAudioSamples); err != nil {
+			addError(tw, "audio/samples.err", err)
+		}
+	}
+	return nil
+}
+
+func addJSON(tw *tar.Writer, name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return addBytes(tw, name, data)
+}
+
+func addBytes(tw *tar.Writer, name string, data []byte) error {
+	hdr := &tar.Header{
+		Name:    name,
+		Mode:    0o644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
+
+func addError(tw *tar.Writer, name string, err error) {
+	_ = addBytes(tw, name, []byte(err.Error()+"\n"))
+}
+
+func addSystemInfo(tw *tar.Writer) error {
+	info := fmt.Sprintf("os=%s\narch=%s\ngo=%s\ncollected_at=%s\n",
+		runtime.GOOS, runtime.GOARCH, runtime.Version(), time.Now().UTC().Format(time.RFC3339))
+	return addBytes(tw, "system/info.txt", []byte(info))
+}
+
+func addContainerLog(tw *tar.Writer, container string) error {
+	out, err := exec.Command("docker", "logs", "--tail", "500", container).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("docker logs %s: %w (output: %s)", container, err, bytes.TrimSpace(out))
+	}
+	return addBytes(tw, "docker/"+container+".log", out)
+}
+
+func addRedactedFile(tw *tar.Writer, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return addBytes(tw, "config/"+baseName(path), redact(data))
+}
+
+// addAPIKeyFingerprints records a short hash per secret field found in path,
+// not the value itself, so a bug report can confirm "is this the same key
+// across these two dumps" without ever exposing the key.
+func addAPIKeyFingerprints(tw *tar.Writer, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	type fingerprintEntry struct {
+		Field       string `json:"field"`
+		Fingerprint string `json:"fingerprint"`
+	}
+
+	var entries []fingerprintEntry
+	for _, m := range secretFieldPattern.FindAllSubmatch(data, -1) {
+		entries = append(entries, fingerprintEntry{
+			Field:       trimFieldName(m[1]),
+			Fingerprint: fingerprint(unquoteValue(string(m[2]))),
+		})
+	}
+	return addJSON(tw, "security/"+baseName(path)+".fingerprints.json", entries)
+}
+
+// trimFieldName strips the trailing separator ("api_key: " -> "api_key") off
+// a secretFieldPattern field-name match.
+func trimFieldName(field []byte) string {
+	s := string(field)
+	for len(s) > 0 {
+		switch s[len(s)-1] {
+		case ':', '=', ' ', '\t':
+			s = s[:len(s)-1]
+			continue
+		}
+		break
+	}
+	return s
+}
+
+// addRecentCDRs embeds the trailing maxLines of the Asterisk CDR CSV so a
+// bundle includes real recent call records, not just a health-check summary.
+func addRecentCDRs(tw *tar.Writer, path string, maxLines int) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	lines := splitLines(data)
+	if len(lines) > maxLines {
+		lines = lines[len(lines)-maxLines:]
+	}
+	return addBytes(tw, "cdr/recent.csv", joinLines(lines))
+}
+
+func splitLines(data []byte) [][]byte {
+	var lines [][]byte
+	start := 0
+	for i, b := range data {
+		if b == '\n' {
+			lines = append(lines, data[start:i])
+			start = i + 1
+		}
+	}
+	if start < len(data) {
+		lines = append(lines, data[start:])
+	}
+	return lines
+}
+
+func joinLines(lines [][]byte) []byte {
+	var out []byte
+	for _, l := range lines {
+		out = append(out, l...)
+		out = append(out, '\n')
+	}
+	return out
+}
+
+// addProviderConnectivity probes each configured provider endpoint and
+// records whether it was reachable, so a connectivity issue shows up as a
+// real trace instead of the static "provider APIs reachable" health message.
+func addProviderConnectivity(tw *tar.Writer, endpoints []ProviderEndpoint) error {
+	type trace struct {
+		Name      string `json:"name"`
+		URL       string `json:"url"`
+		Reachable bool   `json:"reachable"`
+		Status    string `json:"status,omitempty"`
+		LatencyMS int64  `json:"latency_ms"`
+		Error     string `json:"error,omitempty"`
+		CheckedAt string `json:"checked_at"`
+	}
+
+	client := http.Client{Timeout: 5 * time.Second}
+	traces := make([]trace, 0, len(endpoints))
+	for _, ep := range endpoints {
+		start := time.Now()
+		t := trace{Name: ep.Name, URL: ep.URL, CheckedAt: start.UTC().Format(time.RFC3339)}
+
+		resp, err := client.Head(ep.URL)
+		t.LatencyMS = time.Since(start).Milliseconds()
+		if err != nil {
+			t.Error = err.Error()
+		} else {
+			resp.Body.Close()
+			t.Reachable = true
+			t.Status = resp.Status
+		}
+		traces = append(traces, t)
+	}
+	return addJSON(tw, "providers/connectivity.json", traces)
+}
+
+const (
+	// audioSampleRate and audioSampleWidth describe the raw SLIN16 PCM the
+	// ava-audiosocket pipeline records: 8kHz, 16-bit signed, mono.
+	audioSampleRate  = 8000
+	audioSampleWidth = 2 // bytes per sample
+
+	// recentRecordingWindow bounds which recordings on disk are considered
+	// "recent" for --include-audio-samples, independent of how many
+	// trailing seconds of each one get embedded.
+	recentRecordingWindow = 24 * time.Hour
+)
+
+// addAudioSamples embeds the trailing `trim` worth of each recent pipeline
+// recording under dir, so a reproduction snippet stays small regardless of
+// how long the original call was.
+func addAudioSamples(tw *tar.Writer, dir string, trim time.Duration) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	cutoff := time.Now().Add(-recentRecordingWindow)
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		fi, err := e.Info()
+		if err != nil || fi.ModTime().Before(cutoff) {
+			continue
+		}
+		data, err := os.ReadFile(dir + "/" + e.Name())
+		if err != nil {
+			continue
+		}
+		if err := addBytes(tw, "audio/"+e.Name(), trimTrailingAudio(data, trim)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// trimTrailingAudio returns the trailing window worth of raw SLIN16 PCM
+// samples from data, aligned to a sample boundary. Recordings shorter than
+// window are returned unmodified.
+func trimTrailingAudio(data []byte, window time.Duration) []byte {
+	maxBytes := int(window.Seconds() * float64(audioSampleRate) * float64(audioSampleWidth))
+	if maxBytes <= 0 || maxBytes >= len(data) {
+		return data
+	}
+	start := len(data) - maxBytes
+	start -= start % audioSampleWidth
+	return data[start:]
+}
+
+func baseName(path string) string {
+	for i := len(path) - 1; i >= 0; i-- {
+		if path[i] == '/' {
+			return path[i+1:]
+		}
+	}
+	return path
+}
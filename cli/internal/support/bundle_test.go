@@ -0,0 +1,54 @@
+package support
+
+import "testing"
+
+func TestRedact(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "bare value",
+			in:   "api_key: abc123\n",
+			want: "api_key: ***REDACTED***\n",
+		},
+		{
+			name: "quoted value with spaces",
+			in:   "password: \"hunter 2 secret\"\n",
+			want: "password: ***REDACTED***\n",
+		},
+		{
+			name: "trailing inline comment",
+			in:   "api_key: abc123 # prod key\n",
+			want: "api_key: ***REDACTED*** # prod key\n",
+		},
+		{
+			name: "non-secret field untouched",
+			in:   "other: value\n",
+			want: "other: value\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := string(redact([]byte(tc.in)))
+			if got != tc.want {
+				t.Errorf("redact(%q) = %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUnquoteValue(t *testing.T) {
+	cases := map[string]string{
+		`"hunter 2 secret"`: "hunter 2 secret",
+		`'hunter 2 secret'`: "hunter 2 secret",
+		"abc123":            "abc123",
+	}
+	for in, want := range cases {
+		if got := unquoteValue(in); got != want {
+			t.Errorf("unquoteValue(%q) = %q, want %q", in, got, want)
+		}
+	}
+}